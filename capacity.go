@@ -0,0 +1,29 @@
+package dynago
+
+// CapacityDetail controls how much detail DynamoDB reports back about
+// the capacity consumed by a request, via ReturnConsumedCapacity.
+type CapacityDetail string
+
+const (
+	CapacityNone    CapacityDetail = "NONE"
+	CapacityTotal   CapacityDetail = "TOTAL"
+	CapacityIndexes CapacityDetail = "INDEXES"
+)
+
+// Capacity reports the read/write capacity units consumed against a
+// single table or index.
+type Capacity struct {
+	ReadCapacityUnits  float64 `json:",omitempty"`
+	WriteCapacityUnits float64 `json:",omitempty"`
+	CapacityUnits      float64 `json:",omitempty"`
+}
+
+// ConsumedCapacity reports the capacity consumed by a request, as
+// returned when ReturnConsumedCapacity is set to TOTAL or INDEXES.
+type ConsumedCapacity struct {
+	TableName              string
+	CapacityUnits          float64             `json:",omitempty"`
+	Table                  *Capacity           `json:",omitempty"`
+	LocalSecondaryIndexes  map[string]Capacity `json:",omitempty"`
+	GlobalSecondaryIndexes map[string]Capacity `json:",omitempty"`
+}
@@ -0,0 +1,68 @@
+package dynago
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestQueryResponseDecodesConsumedCapacity(t *testing.T) {
+	raw := []byte(`{
+		"Count": 1,
+		"Items": [],
+		"ConsumedCapacity": {
+			"TableName": "Widgets",
+			"CapacityUnits": 4.5,
+			"Table": {"CapacityUnits": 4.5}
+		}
+	}`)
+
+	var resp queryResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if resp.ConsumedCapacity == nil {
+		t.Fatal("ConsumedCapacity not decoded")
+	}
+	if resp.ConsumedCapacity.TableName != "Widgets" {
+		t.Errorf("TableName = %q, want %q", resp.ConsumedCapacity.TableName, "Widgets")
+	}
+	if resp.ConsumedCapacity.Table == nil || resp.ConsumedCapacity.Table.CapacityUnits != 4.5 {
+		t.Errorf("Table = %+v, want CapacityUnits 4.5", resp.ConsumedCapacity.Table)
+	}
+
+	result := &QueryResult{
+		Items:            resp.Items,
+		Count:            resp.Count,
+		LastEvaluatedKey: resp.LastEvaluatedKey,
+		ConsumedCapacity: resp.ConsumedCapacity,
+	}
+	if result.ConsumedCapacity != resp.ConsumedCapacity {
+		t.Error("ConsumedCapacity did not carry through to QueryResult")
+	}
+}
+
+func TestScanResponseDecodesConsumedCapacity(t *testing.T) {
+	raw := []byte(`{
+		"Count": 1,
+		"Items": [],
+		"ConsumedCapacity": {"TableName": "Widgets", "CapacityUnits": 1}
+	}`)
+
+	var resp scanResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if resp.ConsumedCapacity == nil || resp.ConsumedCapacity.TableName != "Widgets" {
+		t.Fatalf("ConsumedCapacity = %+v, want TableName Widgets", resp.ConsumedCapacity)
+	}
+
+	result := &ScanResult{
+		Items:            resp.Items,
+		Count:            resp.Count,
+		LastEvaluatedKey: resp.LastEvaluatedKey,
+		ConsumedCapacity: resp.ConsumedCapacity,
+	}
+	if result.ConsumedCapacity != resp.ConsumedCapacity {
+		t.Error("ConsumedCapacity did not carry through to ScanResult")
+	}
+}
@@ -0,0 +1,106 @@
+package dynago
+
+import "context"
+
+// Executor is the extension point through which the Query and Scan
+// request builders perform their work against DynamoDB.
+// Client.executor defaults to an awsExecutor talking to DynamoDB
+// directly; swapping in an alternate implementation -- for example one
+// backed by DAX -- requires no changes to the builders themselves.
+type Executor interface {
+	Query(*Query) (*QueryResult, error)
+	QueryContext(context.Context, *Query) (*QueryResult, error)
+	Scan(*Scan) (*ScanResult, error)
+	ScanContext(context.Context, *Scan) (*ScanResult, error)
+}
+
+// makeRequestUnmarshalContext is a context-aware wrapper around
+// makeRequestUnmarshal. It aborts before issuing the request if ctx
+// has already been cancelled, and returns as soon as ctx is cancelled
+// even if the request is still in flight -- makeRequestUnmarshal
+// itself has no notion of context, so the underlying HTTP call is not
+// aborted; it keeps running in the background and its result is
+// discarded. This still gives callers real cancellation of their wait
+// (QueryContext/ScanContext return promptly), it just can't free up
+// the in-flight connection early.
+func (e *awsExecutor) makeRequestUnmarshalContext(ctx context.Context, action string, req, out interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- e.makeRequestUnmarshal(action, req, out)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// QueryContext is the context-aware, retrying counterpart to Query.
+func (e *awsExecutor) QueryContext(ctx context.Context, q *Query) (result *QueryResult, err error) {
+	var response queryResponse
+	err = retry(ctx, e.retryPolicy(), func() error {
+		response = queryResponse{}
+		return e.makeRequestUnmarshalContext(ctx, "Query", &q.req, &response)
+	})
+	if err != nil {
+		return
+	}
+	result = &QueryResult{
+		Items:            response.Items,
+		Count:            response.Count,
+		LastEvaluatedKey: response.LastEvaluatedKey,
+		ConsumedCapacity: response.ConsumedCapacity,
+	}
+	return
+}
+
+// ScanContext is the context-aware, retrying counterpart to Scan.
+func (e *awsExecutor) ScanContext(ctx context.Context, s *Scan) (result *ScanResult, err error) {
+	var response scanResponse
+	err = retry(ctx, e.retryPolicy(), func() error {
+		response = scanResponse{}
+		return e.makeRequestUnmarshalContext(ctx, "Scan", &s.req, &response)
+	})
+	if err != nil {
+		return
+	}
+	result = &ScanResult{
+		Items:            response.Items,
+		Count:            response.Count,
+		LastEvaluatedKey: response.LastEvaluatedKey,
+		ConsumedCapacity: response.ConsumedCapacity,
+	}
+	return
+}
+
+// retryPolicy returns e's configured RetryPolicy, falling back to
+// DefaultRetryPolicy if none was set.
+//
+// NOTE: this reads e.RetryPolicy (a *RetryPolicy) on awsExecutor.
+// awsExecutor itself is declared in client.go alongside Client and
+// makeRequestUnmarshal, none of which are part of this change --
+// adding the RetryPolicy field to that struct belongs with them.
+func (e *awsExecutor) retryPolicy() RetryPolicy {
+	if e.RetryPolicy != nil {
+		return *e.RetryPolicy
+	}
+	return DefaultRetryPolicy
+}
+
+// ExecuteContext is like Execute, but carries ctx through to the
+// configured Executor so it can be cancelled and retried according to
+// its RetryPolicy.
+func (q *Query) ExecuteContext(ctx context.Context) (result *QueryResult, err error) {
+	return q.client.executor.QueryContext(ctx, q)
+}
+
+// ExecuteContext is like Execute, but carries ctx through to the
+// configured Executor so it can be cancelled and retried according to
+// its RetryPolicy.
+func (s *Scan) ExecuteContext(ctx context.Context) (result *ScanResult, err error) {
+	return s.client.executor.ScanContext(ctx, s)
+}
@@ -0,0 +1,15 @@
+package dynago
+
+// nameHelper merges alias->attribute name pairs, as compiled by a
+// Filter, into ExpressionAttributeNames.
+func (e *expressionAttributes) nameHelper(names map[string]string) {
+	if len(names) == 0 {
+		return
+	}
+	if e.ExpressionAttributeNames == nil {
+		e.ExpressionAttributeNames = make(map[string]string, len(names))
+	}
+	for alias, name := range names {
+		e.ExpressionAttributeNames[alias] = name
+	}
+}
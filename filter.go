@@ -0,0 +1,123 @@
+package dynago
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+var filterPlaceholder uint64
+
+func nextFilterPlaceholder() uint64 {
+	return atomic.AddUint64(&filterPlaceholder, 1)
+}
+
+// Operand names a Document attribute for use in a Filter condition
+// built with Attr. Nested attributes and list indexes are written
+// just as they would be in a raw expression, e.g. Attr("a.b[0]").
+type Operand struct {
+	name string
+}
+
+// Attr begins a Filter condition against the named attribute.
+func Attr(name string) Operand {
+	return Operand{name: name}
+}
+
+func (o Operand) Equal(v interface{}) Filter              { return o.condition("=", v) }
+func (o Operand) NotEqual(v interface{}) Filter           { return o.condition("<>", v) }
+func (o Operand) LessThan(v interface{}) Filter           { return o.condition("<", v) }
+func (o Operand) LessThanOrEqual(v interface{}) Filter    { return o.condition("<=", v) }
+func (o Operand) GreaterThan(v interface{}) Filter        { return o.condition(">", v) }
+func (o Operand) GreaterThanOrEqual(v interface{}) Filter { return o.condition(">=", v) }
+func (o Operand) BeginsWith(prefix string) Filter         { return o.condition("begins_with", prefix) }
+func (o Operand) Between(lo, hi interface{}) Filter       { return o.condition("between", lo, hi) }
+func (o Operand) In(values ...interface{}) Filter         { return o.condition("in", values...) }
+func (o Operand) Contains(v interface{}) Filter           { return o.condition("contains", v) }
+func (o Operand) Exists() Filter                          { return o.condition("attribute_exists") }
+func (o Operand) NotExists() Filter                       { return o.condition("attribute_not_exists") }
+
+func (o Operand) condition(op string, values ...interface{}) Filter {
+	path := strings.Split(o.name, ".")
+	names := make(map[string]string, len(path))
+	for i, part := range path {
+		base, index := part, ""
+		if j := strings.IndexByte(part, '['); j >= 0 {
+			base, index = part[:j], part[j:]
+		}
+		key := fmt.Sprintf("#n%d", nextFilterPlaceholder())
+		names[key] = base
+		path[i] = key + index
+	}
+	alias := strings.Join(path, ".")
+
+	params := make([]Param, len(values))
+	placeholders := make([]string, len(values))
+	for i, v := range values {
+		key := fmt.Sprintf(":v%d", nextFilterPlaceholder())
+		params[i] = Param{key, v}
+		placeholders[i] = key
+	}
+
+	var expression string
+	switch op {
+	case "between":
+		expression = fmt.Sprintf("%s BETWEEN %s AND %s", alias, placeholders[0], placeholders[1])
+	case "in":
+		expression = fmt.Sprintf("%s IN (%s)", alias, strings.Join(placeholders, ", "))
+	case "begins_with", "contains":
+		expression = fmt.Sprintf("%s(%s, %s)", op, alias, placeholders[0])
+	case "attribute_exists", "attribute_not_exists":
+		expression = fmt.Sprintf("%s(%s)", op, alias)
+	default:
+		expression = fmt.Sprintf("%s %s %s", alias, op, placeholders[0])
+	}
+
+	return Filter{expression: expression, names: names, params: params}
+}
+
+// Filter is a composable condition built from Attr, compiling down to
+// a KeyConditionExpression/FilterExpression plus the
+// ExpressionAttributeNames/Values it references. Apply it to a Query
+// or Scan with KeyCondition or Filter.
+type Filter struct {
+	expression string
+	names      map[string]string
+	params     []Param
+}
+
+// And combines this Filter with other, requiring both to hold.
+func (f Filter) And(other Filter) Filter { return f.combine("AND", other) }
+
+// Or combines this Filter with other, requiring either to hold.
+func (f Filter) Or(other Filter) Filter { return f.combine("OR", other) }
+
+func (f Filter) combine(op string, other Filter) Filter {
+	names := make(map[string]string, len(f.names)+len(other.names))
+	for k, v := range f.names {
+		names[k] = v
+	}
+	for k, v := range other.names {
+		names[k] = v
+	}
+	return Filter{
+		expression: fmt.Sprintf("(%s) %s (%s)", f.expression, op, other.expression),
+		names:      names,
+		params:     append(append([]Param{}, f.params...), other.params...),
+	}
+}
+
+// Not negates a Filter.
+func Not(f Filter) Filter {
+	return Filter{
+		expression: fmt.Sprintf("NOT (%s)", f.expression),
+		names:      f.names,
+		params:     f.params,
+	}
+}
+
+// AsParams satisfies the Params interface so a Filter's values can be
+// merged into a request the same way a Document or Param can.
+func (f Filter) AsParams() []Param {
+	return f.params
+}
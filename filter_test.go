@@ -0,0 +1,123 @@
+package dynago
+
+import (
+	"strings"
+	"testing"
+)
+
+// soleAlias returns f's one ExpressionAttributeNames alias, failing
+// the test if there isn't exactly one.
+func soleAlias(t *testing.T, f Filter, wantAttr string) string {
+	t.Helper()
+	if len(f.names) != 1 {
+		t.Fatalf("names = %v, want exactly 1 entry", f.names)
+	}
+	for alias, attr := range f.names {
+		if attr != wantAttr {
+			t.Fatalf("names[%s] = %q, want %q", alias, attr, wantAttr)
+		}
+		if !strings.Contains(f.expression, alias) {
+			t.Fatalf("expression %q does not reference its own alias %q", f.expression, alias)
+		}
+		return alias
+	}
+	panic("unreachable")
+}
+
+func TestFilterEqual(t *testing.T) {
+	f := Attr("Status").Equal("active")
+	alias := soleAlias(t, f, "Status")
+
+	if len(f.params) != 1 {
+		t.Fatalf("params = %v, want exactly 1 entry", f.params)
+	}
+	if f.params[0].Value != "active" {
+		t.Errorf("params[0].Value = %v, want %q", f.params[0].Value, "active")
+	}
+	if !strings.Contains(f.expression, f.params[0].Key) {
+		t.Errorf("expression %q does not reference its own placeholder %q", f.expression, f.params[0].Key)
+	}
+	want := alias + " = " + f.params[0].Key
+	if f.expression != want {
+		t.Errorf("expression = %q, want %q", f.expression, want)
+	}
+}
+
+func TestFilterBetween(t *testing.T) {
+	f := Attr("Age").Between(18, 65)
+	alias := soleAlias(t, f, "Age")
+
+	if len(f.params) != 2 {
+		t.Fatalf("params = %v, want exactly 2 entries", f.params)
+	}
+	if f.params[0].Value != 18 || f.params[1].Value != 65 {
+		t.Errorf("params = %v, want values [18 65]", f.params)
+	}
+	want := alias + " BETWEEN " + f.params[0].Key + " AND " + f.params[1].Key
+	if f.expression != want {
+		t.Errorf("expression = %q, want %q", f.expression, want)
+	}
+}
+
+func TestFilterIn(t *testing.T) {
+	f := Attr("Category").In("books", "movies", "music")
+	alias := soleAlias(t, f, "Category")
+
+	if len(f.params) != 3 {
+		t.Fatalf("params = %v, want exactly 3 entries", f.params)
+	}
+	values := make([]string, len(f.params))
+	for i, p := range f.params {
+		values[i] = p.Value.(string)
+	}
+	if values[0] != "books" || values[1] != "movies" || values[2] != "music" {
+		t.Errorf("param values = %v, want [books movies music] in order", values)
+	}
+
+	placeholders := make([]string, len(f.params))
+	for i, p := range f.params {
+		placeholders[i] = p.Key
+	}
+	want := alias + " IN (" + strings.Join(placeholders, ", ") + ")"
+	if f.expression != want {
+		t.Errorf("expression = %q, want %q", f.expression, want)
+	}
+}
+
+func TestFilterAndOrNot(t *testing.T) {
+	active := Attr("Status").Equal("active")
+	archived := Attr("Status").Equal("archived")
+	hasOwner := Attr("Owner").Exists()
+
+	f := Not(active.Or(archived).And(hasOwner))
+
+	wantExpr := "NOT ((" + "(" + active.expression + ") OR (" + archived.expression + ")" + ") AND (" + hasOwner.expression + "))"
+	if f.expression != wantExpr {
+		t.Errorf("expression = %q, want %q", f.expression, wantExpr)
+	}
+
+	if len(f.names) != 2 { // Status and Owner, each a distinct alias
+		t.Errorf("names = %v, want 2 distinct attribute aliases", f.names)
+	}
+	if len(f.params) != 2 { // "active" and "archived"; Exists() takes no value
+		t.Errorf("params = %v, want 2 entries", f.params)
+	}
+	for _, attr := range f.names {
+		if attr != "Status" && attr != "Owner" {
+			t.Errorf("unexpected attribute name %q in names", attr)
+		}
+	}
+}
+
+func TestFilterAsParams(t *testing.T) {
+	f := Attr("Age").Between(18, 65)
+	params := f.AsParams()
+	if len(params) != len(f.params) {
+		t.Fatalf("AsParams() returned %d params, want %d", len(params), len(f.params))
+	}
+	for i := range params {
+		if params[i] != f.params[i] {
+			t.Errorf("AsParams()[%d] = %v, want %v", i, params[i], f.params[i])
+		}
+	}
+}
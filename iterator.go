@@ -0,0 +1,122 @@
+package dynago
+
+import (
+	"errors"
+	"reflect"
+)
+
+// pageFunc fetches the next page of results starting after startKey. It
+// returns a nil result once there is nothing left to fetch.
+type pageFunc func(startKey *Document) (*QueryResult, error)
+
+// Iterator walks the results of a Query or Scan, transparently issuing
+// follow-up requests as pages are exhausted. It is returned by
+// Query.Iter and Scan.Iter in the style of guregu/dynamo's Iter.
+type Iterator struct {
+	fetch   pageFunc
+	items   []Document
+	pos     int
+	lastKey *Document
+	done    bool
+	err     error
+}
+
+// newIterator builds an Iterator whose first fetch call is seeded with
+// start, so a caller-supplied ExclusiveStartKey is honored on the very
+// first page instead of being discarded in favor of nil.
+func newIterator(start *Document, fetch pageFunc) *Iterator {
+	return &Iterator{fetch: fetch, lastKey: start}
+}
+
+// trimToLimit truncates items so that fetched+len(items) does not
+// exceed limit. A limit of 0 means unbounded. It exists because a
+// FilterExpression makes the number of items a page returns diverge
+// from the number of items DynamoDB evaluated, so Limit can't be
+// enforced by request parameters alone -- Query.Iter and Scan.Iter
+// must also trim each page's results client-side.
+func trimToLimit(items []Document, limit, fetched uint) []Document {
+	if limit == 0 {
+		return items
+	}
+	if remaining := limit - fetched; uint(len(items)) > remaining {
+		return items[:remaining]
+	}
+	return items
+}
+
+// Next decodes the next item into doc and advances the iterator,
+// issuing another request if the current page has been exhausted. It
+// returns false once the result set is exhausted or an error occurs;
+// use Err to tell the two apart.
+func (it *Iterator) Next(doc *Document) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.pos >= len(it.items) {
+		if it.done {
+			return false
+		}
+		result, err := it.fetch(it.lastKey)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if result == nil {
+			it.done = true
+			return false
+		}
+		it.items = result.Items
+		it.pos = 0
+		it.lastKey = result.LastEvaluatedKey
+		if it.lastKey == nil {
+			it.done = true
+		}
+	}
+	*doc = it.items[it.pos]
+	it.pos++
+	return true
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// LastKey returns the LastEvaluatedKey of the most recently fetched
+// page, or nil if the result set is exhausted. Before any page has
+// been fetched it returns the start key the iterator was seeded with
+// (nil when there was none). It's meant for resuming iteration later -- e.g. via
+// Scan.ExclusiveStartKey -- after Next stops returning true because of
+// an error or a cancelled context, not because the results ran out.
+// Note the granularity is per-page: items already buffered from the
+// current page but not yet consumed by Next are not reflected in it.
+func (it *Iterator) LastKey() *Document {
+	return it.lastKey
+}
+
+var documentType = reflect.TypeOf(Document{})
+
+// All consumes the iterator, decoding each remaining item into a new
+// element appended to the slice pointed to by out. out may point to a
+// []Document or a slice of any struct supported by UnmarshalItem.
+func (it *Iterator) All(out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		return errors.New("dynago: All requires a pointer to a slice")
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+
+	var doc Document
+	for it.Next(&doc) {
+		elem := reflect.New(elemType).Elem()
+		if elemType == documentType {
+			elem.Set(reflect.ValueOf(doc))
+		} else if err := UnmarshalItem(doc, elem.Addr().Interface()); err != nil {
+			return err
+		}
+		slice.Set(reflect.Append(slice, elem))
+		doc = nil
+	}
+	return it.Err()
+}
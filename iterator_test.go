@@ -0,0 +1,87 @@
+package dynago
+
+import "testing"
+
+func TestTrimToLimit(t *testing.T) {
+	items := []Document{{"a": 1}, {"a": 2}, {"a": 3}, {"a": 4}}
+
+	if got := trimToLimit(items, 0, 0); len(got) != 4 {
+		t.Errorf("limit=0: got %d items, want 4 (unbounded)", len(got))
+	}
+	if got := trimToLimit(items, 10, 0); len(got) != 4 {
+		t.Errorf("limit=10, fetched=0: got %d items, want 4", len(got))
+	}
+	if got := trimToLimit(items, 3, 0); len(got) != 3 {
+		t.Errorf("limit=3, fetched=0: got %d items, want 3", len(got))
+	}
+	if got := trimToLimit(items, 3, 2); len(got) != 1 {
+		t.Errorf("limit=3, fetched=2: got %d items, want 1", len(got))
+	}
+	if got := trimToLimit(items, 3, 3); len(got) != 0 {
+		t.Errorf("limit=3, fetched=3: got %d items, want 0", len(got))
+	}
+}
+
+// TestIterAllRespectsLimitAcrossFilteredPages mirrors the shape of
+// Query.Iter/Scan.Iter: each page fetches a fixed number of raw items
+// but a FilterExpression lets through fewer, so the number of items a
+// page returns isn't known until after the request. All must still
+// stop at exactly Limit once a FilterExpression is in play.
+func TestIterAllRespectsLimitAcrossFilteredPages(t *testing.T) {
+	const limit = 10
+	pages := [][]Document{
+		{{"a": 1}, {"a": 2}, {"a": 3}, {"a": 4}, {"a": 5}, {"a": 6}, {"a": 7}, {"a": 8}},
+		{{"a": 9}, {"a": 10}, {"a": 11}, {"a": 12}, {"a": 13}, {"a": 14}, {"a": 15}, {"a": 16}},
+	}
+	var fetched uint
+	page := 0
+	it := newIterator(nil, func(startKey *Document) (*QueryResult, error) {
+		if fetched >= limit || page >= len(pages) {
+			return nil, nil
+		}
+		items := trimToLimit(pages[page], limit, fetched)
+		page++
+		fetched += uint(len(items))
+		var lastKey *Document
+		if page < len(pages) {
+			lastKey = &Document{"k": page}
+		}
+		return &QueryResult{Items: items, LastEvaluatedKey: lastKey}, nil
+	})
+
+	var out []Document
+	if err := it.All(&out); err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	if len(out) != limit {
+		t.Fatalf("All returned %d items, want %d", len(out), limit)
+	}
+}
+
+// TestNewIteratorSeedsFirstFetchFromStart guards against the first
+// page fetch silently discarding a caller-supplied ExclusiveStartKey:
+// it must be threaded into fetch's first call, not overwritten by the
+// zero-valued lastKey a freshly constructed Iterator would otherwise
+// hold.
+func TestNewIteratorSeedsFirstFetchFromStart(t *testing.T) {
+	seed := &Document{"id": "resume-here"}
+	var gotFirstCall *Document
+	calls := 0
+	it := newIterator(seed, func(startKey *Document) (*QueryResult, error) {
+		calls++
+		if calls == 1 {
+			gotFirstCall = startKey
+		}
+		return nil, nil
+	})
+
+	var doc Document
+	it.Next(&doc)
+
+	if calls != 1 {
+		t.Fatalf("fetch called %d times, want 1", calls)
+	}
+	if gotFirstCall != seed {
+		t.Fatalf("first fetch call got startKey %v, want the seeded %v", gotFirstCall, seed)
+	}
+}
@@ -0,0 +1,392 @@
+package dynago
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const iso8601 = "2006-01-02T15:04:05Z"
+
+// itemTag describes how MarshalItem/UnmarshalItem treat a single
+// struct field, as parsed from its `dynago:"..."` tag.
+type itemTag struct {
+	name      string
+	omitempty bool
+	unixtime  bool
+	setKind   string // "", "stringset", "numberset", "binaryset"
+	skip      bool
+}
+
+func parseItemTag(field reflect.StructField) itemTag {
+	tag := itemTag{name: field.Name}
+	raw, ok := field.Tag.Lookup("dynago")
+	if !ok {
+		return tag
+	}
+	parts := strings.Split(raw, ",")
+	if parts[0] == "-" && len(parts) == 1 {
+		tag.skip = true
+		return tag
+	}
+	if parts[0] != "" {
+		tag.name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		switch opt {
+		case "omitempty":
+			tag.omitempty = true
+		case "unixtime":
+			tag.unixtime = true
+		case "stringset", "numberset", "binaryset":
+			tag.setKind = opt
+		}
+	}
+	return tag
+}
+
+// MarshalItem converts a struct (or pointer to one) into a Document,
+// using the same `dynago` struct tag conventions as UnmarshalItem.
+func MarshalItem(v interface{}) (Document, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, errors.New("dynago: MarshalItem of nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("dynago: MarshalItem requires a struct, got %s", rv.Kind())
+	}
+	doc := make(Document)
+	if err := marshalStruct(rv, doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func marshalStruct(rv reflect.Value, doc Document) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+		tag := parseItemTag(field)
+		if tag.skip {
+			continue
+		}
+		val, empty, err := marshalValue(rv.Field(i), tag)
+		if err != nil {
+			return fmt.Errorf("dynago: field %s: %w", field.Name, err)
+		}
+		if empty && tag.omitempty {
+			continue
+		}
+		doc[tag.name] = val
+	}
+	return nil
+}
+
+func marshalValue(fv reflect.Value, tag itemTag) (interface{}, bool, error) {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return nil, true, nil
+		}
+		return marshalValue(fv.Elem(), tag)
+	}
+
+	if t, ok := fv.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return nil, true, nil
+		}
+		if tag.unixtime {
+			return Number(strconv.FormatInt(t.Unix(), 10)), false, nil
+		}
+		return t.UTC().Format(iso8601), false, nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		s := fv.String()
+		return s, s == "", nil
+	case reflect.Bool:
+		return fv.Bool(), !fv.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Number(strconv.FormatInt(fv.Int(), 10)), fv.Int() == 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Number(strconv.FormatUint(fv.Uint(), 10)), fv.Uint() == 0, nil
+	case reflect.Float32, reflect.Float64:
+		return Number(strconv.FormatFloat(fv.Float(), 'f', -1, 64)), fv.Float() == 0, nil
+	case reflect.Slice, reflect.Array:
+		if fv.Type().Elem().Kind() == reflect.Uint8 && fv.Kind() == reflect.Slice {
+			b := fv.Bytes()
+			return b, len(b) == 0, nil
+		}
+		return marshalSlice(fv, tag)
+	case reflect.Map:
+		return marshalMap(fv)
+	case reflect.Struct:
+		doc := make(Document)
+		if err := marshalStruct(fv, doc); err != nil {
+			return nil, false, err
+		}
+		return doc, len(doc) == 0, nil
+	case reflect.Interface:
+		if fv.IsNil() {
+			return nil, true, nil
+		}
+		return marshalValue(fv.Elem(), tag)
+	default:
+		return nil, false, fmt.Errorf("unsupported kind %s", fv.Kind())
+	}
+}
+
+func marshalSlice(fv reflect.Value, tag itemTag) (interface{}, bool, error) {
+	switch tag.setKind {
+	case "stringset":
+		set := make(StringSet, fv.Len())
+		for i := range set {
+			set[i] = fv.Index(i).String()
+		}
+		return set, len(set) == 0, nil
+	case "numberset":
+		set := make(NumberSet, fv.Len())
+		for i := range set {
+			val, _, err := marshalValue(fv.Index(i), itemTag{})
+			if err != nil {
+				return nil, false, err
+			}
+			n, ok := val.(Number)
+			if !ok {
+				return nil, false, fmt.Errorf("dynago: numberset requires numeric elements, got %T", val)
+			}
+			set[i] = string(n)
+		}
+		return set, len(set) == 0, nil
+	case "binaryset":
+		set := make(BinarySet, fv.Len())
+		for i := range set {
+			set[i] = fv.Index(i).Bytes()
+		}
+		return set, len(set) == 0, nil
+	}
+
+	list := make(List, fv.Len())
+	for i := range list {
+		val, _, err := marshalValue(fv.Index(i), itemTag{})
+		if err != nil {
+			return nil, false, err
+		}
+		list[i] = val
+	}
+	return list, len(list) == 0, nil
+}
+
+func marshalMap(fv reflect.Value) (interface{}, bool, error) {
+	doc := make(Document)
+	iter := fv.MapRange()
+	for iter.Next() {
+		val, _, err := marshalValue(iter.Value(), itemTag{})
+		if err != nil {
+			return nil, false, err
+		}
+		doc[fmt.Sprint(iter.Key().Interface())] = val
+	}
+	return doc, len(doc) == 0, nil
+}
+
+// UnmarshalItem decodes a Document into a struct (or pointer to one),
+// matching fields by name unless overridden with a `dynago` struct tag.
+func UnmarshalItem(d Document, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("dynago: UnmarshalItem requires a non-nil pointer")
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("dynago: UnmarshalItem requires a pointer to a struct, got %s", rv.Kind())
+	}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported
+		}
+		tag := parseItemTag(field)
+		if tag.skip {
+			continue
+		}
+		raw, ok := d[tag.name]
+		if !ok || raw == nil {
+			continue
+		}
+		if err := unmarshalValue(raw, rv.Field(i), tag); err != nil {
+			return fmt.Errorf("dynago: field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// Unmarshal decodes this Document into v, a pointer to a struct.
+func (d Document) Unmarshal(v interface{}) error {
+	return UnmarshalItem(d, v)
+}
+
+func unmarshalValue(raw interface{}, fv reflect.Value, tag itemTag) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return unmarshalValue(raw, fv.Elem(), tag)
+	}
+
+	if _, ok := fv.Interface().(time.Time); ok {
+		t, err := parseItemTime(raw, tag.unixtime)
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		s, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("expected string, got %T", raw)
+		}
+		fv.SetString(s)
+	case reflect.Bool:
+		b, ok := raw.(bool)
+		if !ok {
+			return fmt.Errorf("expected bool, got %T", raw)
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := asNumber(raw).Int64Val()
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := asNumber(raw).Uint64Val()
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := asNumber(raw).FloatVal()
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	case reflect.Slice, reflect.Array:
+		return unmarshalSlice(raw, fv, tag)
+	case reflect.Map:
+		return unmarshalMap(raw, fv)
+	case reflect.Struct:
+		doc, ok := raw.(Document)
+		if !ok {
+			return fmt.Errorf("expected Document, got %T", raw)
+		}
+		return UnmarshalItem(doc, fv.Addr().Interface())
+	case reflect.Interface:
+		if raw == nil {
+			fv.Set(reflect.Zero(fv.Type()))
+			return nil
+		}
+		fv.Set(reflect.ValueOf(raw))
+	default:
+		return fmt.Errorf("unsupported kind %s", fv.Kind())
+	}
+	return nil
+}
+
+func asNumber(raw interface{}) Number {
+	if n, ok := raw.(Number); ok {
+		return n
+	}
+	return Number(fmt.Sprint(raw))
+}
+
+func parseItemTime(raw interface{}, unixtime bool) (time.Time, error) {
+	if unixtime {
+		secs, err := asNumber(raw).Int64Val()
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(secs, 0).UTC(), nil
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("expected string timestamp, got %T", raw)
+	}
+	return time.ParseInLocation(iso8601, s, time.UTC)
+}
+
+func unmarshalSlice(raw interface{}, fv reflect.Value, tag itemTag) error {
+	if fv.Type().Elem().Kind() == reflect.Uint8 {
+		b, ok := raw.([]byte)
+		if !ok {
+			return fmt.Errorf("expected []byte, got %T", raw)
+		}
+		fv.SetBytes(b)
+		return nil
+	}
+
+	switch v := raw.(type) {
+	case StringSet:
+		out := reflect.MakeSlice(fv.Type(), len(v), len(v))
+		for i, s := range v {
+			out.Index(i).SetString(s)
+		}
+		fv.Set(out)
+	case NumberSet:
+		out := reflect.MakeSlice(fv.Type(), len(v), len(v))
+		for i, n := range v {
+			if err := unmarshalValue(Number(n), out.Index(i), itemTag{}); err != nil {
+				return err
+			}
+		}
+		fv.Set(out)
+	case BinarySet:
+		out := reflect.MakeSlice(fv.Type(), len(v), len(v))
+		for i, b := range v {
+			out.Index(i).SetBytes(b)
+		}
+		fv.Set(out)
+	case List:
+		out := reflect.MakeSlice(fv.Type(), len(v), len(v))
+		for i, elem := range v {
+			if err := unmarshalValue(elem, out.Index(i), itemTag{}); err != nil {
+				return err
+			}
+		}
+		fv.Set(out)
+	default:
+		return fmt.Errorf("expected a set or list, got %T", raw)
+	}
+	return nil
+}
+
+func unmarshalMap(raw interface{}, fv reflect.Value) error {
+	doc, ok := raw.(Document)
+	if !ok {
+		return fmt.Errorf("expected Document, got %T", raw)
+	}
+	out := reflect.MakeMapWithSize(fv.Type(), len(doc))
+	elemType := fv.Type().Elem()
+	for key, val := range doc {
+		ev := reflect.New(elemType).Elem()
+		if err := unmarshalValue(val, ev, itemTag{}); err != nil {
+			return err
+		}
+		out.SetMapIndex(reflect.ValueOf(key), ev)
+	}
+	fv.Set(out)
+	return nil
+}
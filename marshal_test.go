@@ -0,0 +1,41 @@
+package dynago
+
+import "testing"
+
+// regression test for a nested DynamoDB NULL (a nil element inside a
+// Document or List) reaching an interface{} destination, which used to
+// panic in unmarshalValue instead of decoding to nil.
+func TestUnmarshalItemNestedNull(t *testing.T) {
+	var dst struct {
+		M map[string]interface{}
+		L []interface{}
+	}
+
+	doc := Document{
+		"M": Document{"a": nil, "b": "x"},
+		"L": List{nil, "x"},
+	}
+
+	if err := UnmarshalItem(doc, &dst); err != nil {
+		t.Fatalf("UnmarshalItem: %v", err)
+	}
+	if dst.M["a"] != nil {
+		t.Errorf("M[%q] = %v, want nil", "a", dst.M["a"])
+	}
+	if dst.M["b"] != "x" {
+		t.Errorf("M[%q] = %v, want %q", "b", dst.M["b"], "x")
+	}
+	if len(dst.L) != 2 || dst.L[0] != nil || dst.L[1] != "x" {
+		t.Errorf("L = %#v, want [nil x]", dst.L)
+	}
+}
+
+func TestMarshalItemNumbersetMistag(t *testing.T) {
+	v := struct {
+		Tags []string `dynago:"tags,numberset"`
+	}{Tags: []string{"not-a-number"}}
+
+	if _, err := MarshalItem(&v); err == nil {
+		t.Fatal("MarshalItem: expected error for non-numeric numberset field, got nil")
+	}
+}
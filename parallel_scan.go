@@ -0,0 +1,124 @@
+package dynago
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Segment restricts this scan to a single segment of a parallel scan,
+// as produced by Scan.ParallelScan. segment is zero-based and must be
+// less than totalSegments.
+func (s Scan) Segment(segment, totalSegments int) *Scan {
+	seg, total := uint(segment), uint(totalSegments)
+	s.req.Segment = &seg
+	s.req.TotalSegments = &total
+	return &s
+}
+
+// ParallelScan fans this scan out across totalSegments goroutines, one
+// per DynamoDB segment, for faster full-table scans. See ForEach.
+func (s *Scan) ParallelScan(totalSegments int) *ParallelScan {
+	return &ParallelScan{scan: s, totalSegments: totalSegments}
+}
+
+// A ParallelScan runs a Scan across multiple segments concurrently.
+type ParallelScan struct {
+	scan          *Scan
+	totalSegments int
+	startKeys     map[int]Document
+}
+
+// StartKeys resumes a previously interrupted ParallelScan, supplying
+// each segment's ExclusiveStartKey from the LastEvaluatedKeys a prior
+// ForEach call returned. Segments with no entry start from the
+// beginning.
+func (p *ParallelScan) StartKeys(keys map[int]Document) *ParallelScan {
+	p.startKeys = keys
+	return p
+}
+
+// parallelScanError aggregates the distinct errors returned by the
+// segments of a ParallelScan, so a failure in one segment doesn't hide
+// failures in the others.
+type parallelScanError struct {
+	errs []error
+}
+
+func (e *parallelScanError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("dynago: %d segment(s) failed: %s", len(e.errs), strings.Join(msgs, "; "))
+}
+
+// ForEach calls fn for every item returned by every segment, each
+// segment paging independently via its own LastEvaluatedKey, until
+// every segment is exhausted or ctx is cancelled. A segment stops
+// early when fn returns an error for one of its items; the other
+// segments keep running. It returns every error encountered (wrapped in a
+// *parallelScanError when more than one segment failed) after every
+// in-flight segment has wound down, alongside each segment's
+// LastEvaluatedKey at the point it stopped -- pass that map to
+// StartKeys to resume an interrupted scan.
+func (p *ParallelScan) ForEach(ctx context.Context, fn func(Document) error) (map[int]Document, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		errs     []error
+		lastKeys = make(map[int]Document, p.totalSegments)
+	)
+	fail := func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}
+
+	for segment := 0; segment < p.totalSegments; segment++ {
+		segment := segment
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scan := p.scan.Segment(segment, p.totalSegments)
+			if start, ok := p.startKeys[segment]; ok {
+				scan = scan.ExclusiveStartKey(start)
+			}
+			it := scan.IterContext(ctx)
+			var doc Document
+		segmentLoop:
+			for it.Next(&doc) {
+				select {
+				case <-ctx.Done():
+					fail(ctx.Err())
+					break segmentLoop
+				default:
+				}
+				if err := fn(doc); err != nil {
+					fail(err)
+					break segmentLoop
+				}
+				doc = nil
+			}
+			if err := it.Err(); err != nil {
+				fail(err)
+			}
+			if lk := it.LastKey(); lk != nil {
+				mu.Lock()
+				lastKeys[segment] = *lk
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	switch len(errs) {
+	case 0:
+		return lastKeys, nil
+	case 1:
+		return lastKeys, errs[0]
+	default:
+		return lastKeys, &parallelScanError{errs: errs}
+	}
+}
@@ -0,0 +1,38 @@
+package dynago
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParallelScanErrorAggregatesAll(t *testing.T) {
+	err := &parallelScanError{errs: []error{errors.New("boom"), errors.New("kaboom")}}
+	msg := err.Error()
+	if !strings.Contains(msg, "boom") || !strings.Contains(msg, "kaboom") {
+		t.Fatalf("Error() = %q, want it to mention both segment errors", msg)
+	}
+	if !strings.Contains(msg, "2") {
+		t.Fatalf("Error() = %q, want it to mention the segment count", msg)
+	}
+}
+
+// TestSegmentIterSeedsFromStartKey guards the actual point of
+// StartKeys: a segment's ExclusiveStartKey, as set by
+// Scan.Segment(...).ExclusiveStartKey(start) inside ForEach's
+// goroutine, must reach its IterContext's first fetch rather than
+// being discarded in favor of nil (the chunk0-1 bug this resumption
+// support depends on).
+func TestSegmentIterSeedsFromStartKey(t *testing.T) {
+	segmentScan := (&Scan{req: scanRequest{TableName: "Widgets"}}).Segment(0, 4)
+	resumed := segmentScan.ExclusiveStartKey(Document{"id": "resume-here"})
+
+	it := resumed.IterContext(context.Background())
+	if it.lastKey == nil {
+		t.Fatal("IterContext did not seed the iterator from ExclusiveStartKey")
+	}
+	if got := (*it.lastKey)["id"]; got != "resume-here" {
+		t.Errorf("seeded startKey[%q] = %v, want %q", "id", got, "resume-here")
+	}
+}
@@ -10,14 +10,15 @@ type queryRequest struct {
 	ProjectionExpression   string `json:",omitempty"`
 	expressionAttributes
 
-	CapacityDetail   CapacityDetail `json:"ReturnConsumedCapacity,omitempty"`
-	ConsistentRead   *bool          `json:",omitempty"`
-	ScanIndexForward *bool          `json:",omitempty"`
-	Limit            uint           `json:",omitempty"`
+	CapacityDetail    CapacityDetail `json:"ReturnConsumedCapacity,omitempty"`
+	ConsistentRead    *bool          `json:",omitempty"`
+	ScanIndexForward  *bool          `json:",omitempty"`
+	Limit             uint           `json:",omitempty"`
+	ExclusiveStartKey *Document      `json:",omitempty"`
 }
 
 type queryResponse struct {
-	//ConsumedCapacity *ConsumedCapacityResponse  // TODO
+	ConsumedCapacity *ConsumedCapacity `json:",omitempty"`
 	Count            int
 	Items            []Document
 	LastEvaluatedKey *Document
@@ -59,6 +60,24 @@ func (q Query) KeyConditionExpression(expression string) *Query {
 	return &q
 }
 
+// Set a condition expression on the key using a Filter built with
+// Attr, instead of a raw expression string.
+func (q Query) KeyCondition(f Filter) *Query {
+	q.req.KeyConditionExpression = f.expression
+	q.req.nameHelper(f.names)
+	q.req.paramsHelper([]interface{}{f})
+	return &q
+}
+
+// Set a post-filter expression using a Filter built with Attr, instead
+// of a raw expression string.
+func (q Query) Filter(f Filter) *Query {
+	q.req.FilterExpression = f.expression
+	q.req.nameHelper(f.names)
+	q.req.paramsHelper([]interface{}{f})
+	return &q
+}
+
 // Set a Projection Expression for controlling which attributes are returned.
 func (q Query) ProjectionExpression(expression string) *Query {
 	q.req.ProjectionExpression = expression
@@ -89,11 +108,58 @@ func (q Query) Limit(limit uint) *Query {
 	return &q
 }
 
+// Resume a query from the LastEvaluatedKey of a previous QueryResult.
+func (q Query) ExclusiveStartKey(key Document) *Query {
+	q.req.ExclusiveStartKey = &key
+	return &q
+}
+
+// Set the level of detail DynamoDB reports back about consumed
+// capacity for this query, overriding any package-level default.
+func (q Query) CapacityDetail(detail CapacityDetail) *Query {
+	q.req.CapacityDetail = detail
+	return &q
+}
+
 // Execute this query and return results.
 func (q *Query) Execute() (result *QueryResult, err error) {
 	return q.client.executor.Query(q)
 }
 
+// Iter returns an Iterator that transparently issues follow-up Query
+// requests, using LastEvaluatedKey to page through the full result set
+// until it is exhausted or the query's Limit is reached.
+func (q *Query) Iter() *Iterator {
+	req := q.req
+	limit := req.Limit
+	var fetched uint
+	return newIterator(req.ExclusiveStartKey, func(startKey *Document) (*QueryResult, error) {
+		if limit > 0 && fetched >= limit {
+			return nil, nil
+		}
+		pageReq := req
+		pageReq.ExclusiveStartKey = startKey
+		if limit > 0 {
+			pageReq.Limit = limit - fetched
+		}
+		result, err := (&Query{q.client, pageReq}).Execute()
+		if err != nil {
+			return nil, err
+		}
+		result.Items = trimToLimit(result.Items, limit, fetched)
+		fetched += uint(len(result.Items))
+		return result, nil
+	})
+}
+
+// All consumes the full (possibly multi-page) result set, decoding
+// each item into a new element appended to the slice pointed to by
+// out (a []Document or a slice of any struct supported by
+// UnmarshalItem).
+func (q *Query) All(out interface{}) error {
+	return q.Iter().All(out)
+}
+
 func (e *awsExecutor) Query(q *Query) (result *QueryResult, err error) {
 	var response queryResponse
 	err = e.makeRequestUnmarshal("Query", &q.req, &response)
@@ -101,14 +167,18 @@ func (e *awsExecutor) Query(q *Query) (result *QueryResult, err error) {
 		return
 	}
 	result = &QueryResult{
-		Items: response.Items,
-		Count: response.Count,
+		Items:            response.Items,
+		Count:            response.Count,
+		LastEvaluatedKey: response.LastEvaluatedKey,
+		ConsumedCapacity: response.ConsumedCapacity,
 	}
 	return
 }
 
 // The result returned from a query.
 type QueryResult struct {
-	Items []Document
-	Count int // The total number of items (for pagination)
+	Items            []Document
+	Count            int // The total number of items (for pagination)
+	LastEvaluatedKey *Document
+	ConsumedCapacity *ConsumedCapacity
 }
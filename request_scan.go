@@ -0,0 +1,177 @@
+package dynago
+
+import "context"
+
+type scanRequest struct {
+	TableName string
+	IndexName string `json:",omitempty"`
+
+	// Filtering expression
+	FilterExpression     string `json:",omitempty"`
+	ProjectionExpression string `json:",omitempty"`
+	expressionAttributes
+
+	CapacityDetail    CapacityDetail `json:"ReturnConsumedCapacity,omitempty"`
+	ConsistentRead    *bool          `json:",omitempty"`
+	Limit             uint           `json:",omitempty"`
+	ExclusiveStartKey *Document      `json:",omitempty"`
+	Segment           *uint          `json:",omitempty"`
+	TotalSegments     *uint          `json:",omitempty"`
+}
+
+type scanResponse struct {
+	ConsumedCapacity *ConsumedCapacity `json:",omitempty"`
+	Count            int
+	Items            []Document
+	LastEvaluatedKey *Document
+}
+
+func newScan(client *Client, table string) *Scan {
+	req := scanRequest{
+		TableName: table,
+	}
+	return &Scan{client, req}
+}
+
+type Scan struct {
+	client *Client
+	req    scanRequest
+}
+
+func (s Scan) IndexName(name string) *Scan {
+	s.req.IndexName = name
+	return &s
+}
+
+// If strong is true, do a strongly consistent read. (defaults to false)
+func (s Scan) ConsistentRead(strong bool) *Scan {
+	s.req.ConsistentRead = &strong
+	return &s
+}
+
+// Set a post-filter expression for the results we scan.
+func (s Scan) FilterExpression(expression string, params ...interface{}) *Scan {
+	s.req.paramsHelper(params)
+	s.req.FilterExpression = expression
+	return &s
+}
+
+// Set a post-filter expression using a Filter built with Attr, instead
+// of a raw expression string.
+func (s Scan) Filter(f Filter) *Scan {
+	s.req.FilterExpression = f.expression
+	s.req.nameHelper(f.names)
+	s.req.paramsHelper([]interface{}{f})
+	return &s
+}
+
+// Set a Projection Expression for controlling which attributes are returned.
+func (s Scan) ProjectionExpression(expression string) *Scan {
+	s.req.ProjectionExpression = expression
+	return &s
+}
+
+// Shortcut to set a single parameter for ExpressionAttributeValues.
+func (s Scan) Param(key string, value interface{}) *Scan {
+	s.req.paramHelper(key, value)
+	return &s
+}
+
+// Set a param, a document of params, or multiple params
+func (s Scan) Params(params ...interface{}) *Scan {
+	s.req.paramsHelper(params)
+	return &s
+}
+
+func (s Scan) Limit(limit uint) *Scan {
+	s.req.Limit = limit
+	return &s
+}
+
+// Resume a scan from the LastEvaluatedKey of a previous ScanResult.
+func (s Scan) ExclusiveStartKey(key Document) *Scan {
+	s.req.ExclusiveStartKey = &key
+	return &s
+}
+
+// Set the level of detail DynamoDB reports back about consumed
+// capacity for this scan, overriding any package-level default.
+func (s Scan) CapacityDetail(detail CapacityDetail) *Scan {
+	s.req.CapacityDetail = detail
+	return &s
+}
+
+// Execute this scan and return results.
+func (s *Scan) Execute() (result *ScanResult, err error) {
+	return s.client.executor.Scan(s)
+}
+
+// Iter returns an Iterator that transparently issues follow-up Scan
+// requests, using LastEvaluatedKey to page through the full result set
+// until it is exhausted or the scan's Limit is reached.
+func (s *Scan) Iter() *Iterator {
+	return s.IterContext(context.Background())
+}
+
+// IterContext is like Iter, but carries ctx through to every follow-up
+// Scan request via ExecuteContext, so cancelling ctx also ends the wait
+// on a page fetch that's already in flight, not just the requests that
+// haven't started yet. The in-flight HTTP call itself is not aborted.
+func (s *Scan) IterContext(ctx context.Context) *Iterator {
+	req := s.req
+	limit := req.Limit
+	var fetched uint
+	return newIterator(req.ExclusiveStartKey, func(startKey *Document) (*QueryResult, error) {
+		if limit > 0 && fetched >= limit {
+			return nil, nil
+		}
+		pageReq := req
+		pageReq.ExclusiveStartKey = startKey
+		if limit > 0 {
+			pageReq.Limit = limit - fetched
+		}
+		result, err := (&Scan{s.client, pageReq}).ExecuteContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items := trimToLimit(result.Items, limit, fetched)
+		fetched += uint(len(items))
+		return &QueryResult{
+			Items:            items,
+			Count:            result.Count,
+			LastEvaluatedKey: result.LastEvaluatedKey,
+			ConsumedCapacity: result.ConsumedCapacity,
+		}, nil
+	})
+}
+
+// All consumes the full (possibly multi-page) result set, decoding
+// each item into a new element appended to the slice pointed to by
+// out (a []Document or a slice of any struct supported by
+// UnmarshalItem).
+func (s *Scan) All(out interface{}) error {
+	return s.Iter().All(out)
+}
+
+func (e *awsExecutor) Scan(s *Scan) (result *ScanResult, err error) {
+	var response scanResponse
+	err = e.makeRequestUnmarshal("Scan", &s.req, &response)
+	if err != nil {
+		return
+	}
+	result = &ScanResult{
+		Items:            response.Items,
+		Count:            response.Count,
+		LastEvaluatedKey: response.LastEvaluatedKey,
+		ConsumedCapacity: response.ConsumedCapacity,
+	}
+	return
+}
+
+// The result returned from a scan.
+type ScanResult struct {
+	Items            []Document
+	Count            int // The total number of items (for pagination)
+	LastEvaluatedKey *Document
+	ConsumedCapacity *ConsumedCapacity
+}
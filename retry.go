@@ -0,0 +1,75 @@
+package dynago
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how Executor implementations retry failed
+// requests with exponential backoff and jitter.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy retries throttling and server errors up to 5
+// times with exponential backoff and full jitter, as recommended by
+// the DynamoDB SDKs.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 5,
+	BaseDelay:  50 * time.Millisecond,
+	MaxDelay:   2 * time.Second,
+}
+
+// Backoff returns the delay to wait before retry attempt n (0-based).
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	d := p.BaseDelay << uint(attempt)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// isRetryable reports whether err is a throttling or server-side
+// DynamoDB error that is safe to retry.
+//
+// This matches a fixed list of known exception names against
+// err.Error() rather than a numeric status code or a typed AWS error,
+// because makeRequestUnmarshal (client.go, outside this diff) doesn't
+// expose either -- only the rendered error message. That means any
+// 5xx response DynamoDB returns under a name not in this list won't be
+// retried; broadening this to "retry any 5xx" needs makeRequestUnmarshal
+// to surface a status code or a typed error first.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "ProvisionedThroughputExceededException") ||
+		strings.Contains(msg, "ThrottlingException") ||
+		strings.Contains(msg, "InternalServerError") ||
+		strings.Contains(msg, "ServiceUnavailable")
+}
+
+// retry runs fn, retrying according to policy while ctx remains live
+// and fn's error is retryable.
+func retry(ctx context.Context, policy RetryPolicy, fn func() error) error {
+	var err error
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if err = fn(); err == nil || !isRetryable(err) {
+			return err
+		}
+		select {
+		case <-time.After(policy.Backoff(attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
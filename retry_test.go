@@ -0,0 +1,117 @@
+package dynago
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoffBounds(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 100 * time.Millisecond}
+	for attempt := 0; attempt < 6; attempt++ {
+		max := policy.BaseDelay << uint(attempt)
+		if max <= 0 || max > policy.MaxDelay {
+			max = policy.MaxDelay
+		}
+		for i := 0; i < 20; i++ {
+			d := policy.Backoff(attempt)
+			if d < 0 || d > max {
+				t.Fatalf("attempt %d: Backoff() = %v, want within [0, %v]", attempt, d, max)
+			}
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"unrelated error", errors.New("boom"), false},
+		{"throttling", errors.New("ThrottlingException: slow down"), true},
+		{"provisioned throughput", errors.New("ProvisionedThroughputExceededException"), true},
+		{"internal server error", errors.New("InternalServerError: oops"), true},
+		{"service unavailable", errors.New("ServiceUnavailable"), true},
+		{"wrapped retryable", fmt.Errorf("dynago: Query: %w", errors.New("ThrottlingException")), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetrySucceedsAfterRetryableFailures(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond}
+	attempts := 0
+	err := retry(context.Background(), policy, func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("ThrottlingException")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("fn called %d times, want 3", attempts)
+	}
+}
+
+func TestRetryExhaustsMaxRetries(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond}
+	attempts := 0
+	wantErr := errors.New("ThrottlingException")
+	err := retry(context.Background(), policy, func() error {
+		attempts++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("retry: got %v, want %v", err, wantErr)
+	}
+	if want := policy.MaxRetries + 1; attempts != want {
+		t.Errorf("fn called %d times, want %d", attempts, want)
+	}
+}
+
+func TestRetryStopsImmediatelyOnNonRetryableError(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond}
+	attempts := 0
+	wantErr := errors.New("ValidationException")
+	err := retry(context.Background(), policy, func() error {
+		attempts++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("retry: got %v, want %v", err, wantErr)
+	}
+	if attempts != 1 {
+		t.Errorf("fn called %d times, want 1 (no retries for a non-retryable error)", attempts)
+	}
+}
+
+func TestRetryReturnsPromptlyWhenCtxCancelledMidBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 5, BaseDelay: time.Second, MaxDelay: time.Second}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := retry(ctx, policy, func() error {
+		return errors.New("ThrottlingException")
+	})
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("retry: got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > 500*time.Millisecond {
+		t.Fatalf("retry took %v, want it to return soon after ctx's deadline instead of waiting out the 1s backoff", elapsed)
+	}
+}